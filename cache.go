@@ -2,54 +2,101 @@ package cache
 
 import (
 	"sort"
+	"sync"
 	"time"
 )
 
+// eventBufferSize bounds the number of pending eviction/insertion
+// notifications. Publishing only blocks the itemOps goroutine if the
+// dispatcher falls behind by more than this many events.
+const eventBufferSize = 256
+
 // A Cache is a thread-safe store for fast item storage and retrieval
 type Cache[T any] struct {
-	itemOps   chan func(map[string]T)
-	expiryOps chan func(map[string]*time.Timer)
+	itemOps     chan func(map[string]T, *cacheStats)
+	inflightOps chan func(map[string]T, map[string]*inflightCall[T], *cacheStats)
+	expiryOps   chan func(map[string]*expiryEntry)
+
+	// capacity and policy bound the cache's size. Both are set at
+	// construction time via WithCapacity and are only ever read from the
+	// itemOps goroutine, so no extra synchronization is required.
+	capacity uint64
+	policy   Policy
+
+	events chan cacheEvent[T]
+
+	subMu         sync.Mutex
+	nextSubID     int
+	evictionSubs  map[int]func(key string, val T, reason EvictReason)
+	insertionSubs map[int]func(key string, val T)
 }
 
 // New returns an empty cache
-func New[T any]() *Cache[T] {
+func New[T any](options ...CacheOption[T]) *Cache[T] {
 	c := &Cache[T]{
-		itemOps:   make(chan func(map[string]T)),
-		expiryOps: make(chan func(map[string]*time.Timer)),
+		itemOps:       make(chan func(map[string]T, *cacheStats)),
+		inflightOps:   make(chan func(map[string]T, map[string]*inflightCall[T], *cacheStats)),
+		expiryOps:     make(chan func(map[string]*expiryEntry)),
+		events:        make(chan cacheEvent[T], eventBufferSize),
+		evictionSubs:  map[int]func(key string, val T, reason EvictReason){},
+		insertionSubs: map[int]func(key string, val T){},
+	}
+
+	for _, option := range options {
+		option(c)
 	}
 
 	go c.loopItemOps()
 	go c.loopExpiryOps()
+	go c.loopEvents()
 	return c
 }
 
+// loopItemOps owns the item map for the lifetime of the cache. inflight
+// shares this goroutine (via a select, rather than its own channel+map pair)
+// because GetOrLoad needs to check for a cached value and join or start a
+// single-flight load atomically with respect to every other item operation.
 func (c *Cache[T]) loopItemOps() {
 	items := map[string]T{}
-	for op := range c.itemOps {
-		op(items)
+	inflight := map[string]*inflightCall[T]{}
+	stats := newCacheStats()
+	for {
+		select {
+		case op := <-c.itemOps:
+			op(items, stats)
+		case op := <-c.inflightOps:
+			op(items, inflight, stats)
+		}
 	}
 }
 
 func (c *Cache[T]) loopExpiryOps() {
-	expiries := map[string]*time.Timer{}
+	expiries := map[string]*expiryEntry{}
 	for op := range c.expiryOps {
 		op(expiries)
 	}
 }
 
+// expiryEntry tracks a key's expiry timer alongside the absolute time it
+// fires, so Save can compute each key's remaining TTL.
+type expiryEntry struct {
+	timer *time.Timer
+	at    time.Time
+}
+
 // Set will set the val into the cache at the specified key.
 // If an entry already exists at the specified key, it will be overwritten.
 // The options param can be used to perform logic after the entry has be inserted.
 func (c *Cache[T]) Set(key string, val T, options ...SetOption[T]) {
-	c.expiryOps <- func(expiries map[string]*time.Timer) {
-		if timer, ok := expiries[key]; ok {
-			timer.Stop()
+	c.expiryOps <- func(expiries map[string]*expiryEntry) {
+		if e, ok := expiries[key]; ok {
+			e.timer.Stop()
 			delete(expiries, key)
 		}
 	}
 
-	c.itemOps <- func(items map[string]T) {
-		items[key] = val
+	c.itemOps <- func(items map[string]T, stats *cacheStats) {
+		c.insert(items, stats, key, val)
 	}
 
 	for _, option := range options {
@@ -57,11 +104,48 @@ func (c *Cache[T]) Set(key string, val T, options ...SetOption[T]) {
 	}
 }
 
+// insert stores val at key within items, updating the eviction policy,
+// enforcing capacity, and publishing insertion/eviction notifications. It
+// must only be called from the goroutine that owns items (loopItemOps).
+func (c *Cache[T]) insert(items map[string]T, stats *cacheStats, key string, val T) {
+	_, existed := items[key]
+	items[key] = val
+
+	if !existed {
+		stats.insertions++
+		c.publishInsertion(key, val)
+	}
+
+	if c.policy != nil {
+		if existed {
+			c.policy.RecordAccess(key)
+		} else {
+			c.policy.RecordInsert(key)
+		}
+
+		if c.capacity > 0 && uint64(len(items)) > c.capacity {
+			if victim, ok := c.policy.Evict(); ok {
+				victimVal := items[victim]
+				delete(items, victim)
+				c.policy.Remove(victim)
+				stats.recordEviction(EvictReasonCapacity)
+				c.publishEviction(victim, victimVal, EvictReasonCapacity)
+			}
+		}
+	}
+}
+
 // Clear removes all entries from the cache
 func (c *Cache[T]) Clear() {
-	c.itemOps <- func(items map[string]T) {
-		for key := range items {
+	c.itemOps <- func(items map[string]T, stats *cacheStats) {
+		for key, val := range items {
 			delete(items, key)
+			stats.recordEviction(EvictReasonCleared)
+			c.publishEviction(key, val, EvictReasonCleared)
+		}
+
+		if c.policy != nil {
+			c.policy.Clear()
 		}
 	}
 }
@@ -81,16 +165,43 @@ func (c *Cache[T]) ClearEvery(d time.Duration) *time.Ticker {
 // Delete removes an entry from the cache at the specified key.
 // If no entry exists at the specified key, no action is taken
 func (c *Cache[T]) Delete(key string) {
-	c.itemOps <- func(items map[string]T) {
+	c.delete(key, EvictReasonDeleted)
+}
+
+// delete removes the entry at key, if any, attributing the removal to reason
+// for the benefit of OnEviction subscribers.
+func (c *Cache[T]) delete(key string, reason EvictReason) {
+	c.itemOps <- func(items map[string]T, stats *cacheStats) {
+		val, ok := items[key]
+		if !ok {
+			return
+		}
+
+		if c.policy != nil {
+			c.policy.Remove(key)
+		}
+
 		delete(items, key)
+		stats.recordEviction(reason)
+		c.publishEviction(key, val, reason)
 	}
 }
 
 // Get retrieves an entry at the specified key
 func (c *Cache[T]) Get(key string) T {
 	result := make(chan T, 1)
-	c.itemOps <- func(items map[string]T) {
-		result <- items[key]
+	c.itemOps <- func(items map[string]T, stats *cacheStats) {
+		v, ok := items[key]
+		if ok {
+			stats.hits++
+			if c.policy != nil {
+				c.policy.RecordAccess(key)
+			}
+		} else {
+			stats.misses++
+		}
+
+		result <- v
 	}
 
 	return <-result
@@ -101,8 +212,17 @@ func (c *Cache[T]) Get(key string) T {
 func (c *Cache[T]) GetOK(key string) (T, bool) {
 	result := make(chan T, 1)
 	exists := make(chan bool, 1)
-	c.itemOps <- func(items map[string]T) {
+	c.itemOps <- func(items map[string]T, stats *cacheStats) {
 		v, ok := items[key]
+		if ok {
+			stats.hits++
+			if c.policy != nil {
+				c.policy.RecordAccess(key)
+			}
+		} else {
+			stats.misses++
+		}
+
 		result <- v
 		exists <- ok
 	}
@@ -113,7 +233,7 @@ func (c *Cache[T]) GetOK(key string) (T, bool) {
 // Items retrieves all entries in the cache
 func (c *Cache[T]) Items() map[string]T {
 	result := make(chan map[string]T, 1)
-	c.itemOps <- func(items map[string]T) {
+	c.itemOps <- func(items map[string]T, stats *cacheStats) {
 		cp := map[string]T{}
 		for key, val := range items {
 			cp[key] = val
@@ -128,7 +248,7 @@ func (c *Cache[T]) Items() map[string]T {
 // Keys retrieves a sorted list of all keys in the cache
 func (c *Cache[T]) Keys() []string {
 	result := make(chan []string, 1)
-	c.itemOps <- func(items map[string]T) {
+	c.itemOps <- func(items map[string]T, stats *cacheStats) {
 		keys := make([]string, 0, len(items))
 		for k := range items {
 			keys = append(keys, k)
@@ -140,3 +260,38 @@ func (c *Cache[T]) Keys() []string {
 
 	return <-result
 }
+
+// Stats returns a snapshot of the cache's activity counters.
+func (c *Cache[T]) Stats() Stats {
+	result := make(chan Stats, 1)
+	c.itemOps <- func(items map[string]T, stats *cacheStats) {
+		byReason := make(map[EvictReason]uint64, len(stats.evictionsByReason))
+		for reason, n := range stats.evictionsByReason {
+			byReason[reason] = n
+		}
+
+		result <- Stats{
+			Hits:              stats.hits,
+			Misses:            stats.misses,
+			Insertions:        stats.insertions,
+			Expirations:       stats.evictionsByReason[EvictReasonExpired],
+			Evictions:         stats.evictions,
+			EvictionsByReason: byReason,
+			Size:              uint64(len(items)),
+		}
+	}
+
+	return <-result
+}
+
+// HitRatio returns Stats().Hits / (Stats().Hits + Stats().Misses), or 0 if
+// the cache has not yet seen a Get, GetOK or GetOrLoad call.
+func (c *Cache[T]) HitRatio() float64 {
+	stats := c.Stats()
+	total := stats.Hits + stats.Misses
+	if total == 0 {
+		return 0
+	}
+
+	return float64(stats.Hits) / float64(total)
+}
@@ -7,34 +7,34 @@ import (
 	"time"
 )
 
-func TestAdd(t *testing.T) {
-	c := New()
-	c.Add("1", 1)
+func TestSet(t *testing.T) {
+	c := New[int]()
+	c.Set("1", 1)
 
 	if result, expected := c.Get("1"), 1; !reflect.DeepEqual(result, expected) {
 		t.Errorf("Result was %#v, expected %#v", result, expected)
 	}
 }
 
-func TestAddf(t *testing.T) {
-	c := New()
-	c.Addf("1", 1, time.Millisecond)
+func TestSetExpire(t *testing.T) {
+	c := New[int]()
+	c.Set("1", 1, Expire[int](time.Millisecond))
 
-	if _, exists := c.Getf("1"); !exists {
+	if _, exists := c.GetOK("1"); !exists {
 		t.Errorf("Entry for key '1' should not have expired yet")
 	}
 
 	time.Sleep(time.Millisecond * 2)
 
-	if _, exists := c.Getf("1"); exists {
+	if _, exists := c.GetOK("1"); exists {
 		t.Errorf("Entry for key '1' should have expired by now")
 	}
 }
 
 func TestClear(t *testing.T) {
-	c := New()
+	c := New[int]()
 	for i := 0; i < 10; i++ {
-		c.Add(strconv.Itoa(i), i)
+		c.Set(strconv.Itoa(i), i)
 	}
 
 	c.Clear()
@@ -45,19 +45,19 @@ func TestClear(t *testing.T) {
 }
 
 func TestDelete(t *testing.T) {
-	c := New()
-	c.Add("1", 1)
+	c := New[int]()
+	c.Set("1", 1)
 	c.Delete("1")
 
-	if _, exists := c.Getf("1"); exists {
+	if _, exists := c.GetOK("1"); exists {
 		t.Errorf("Entry for key '1' should not exist")
 	}
 }
 
 func TestClearEvery(t *testing.T) {
-	c := New()
+	c := New[int]()
 	for i := 0; i < 10; i++ {
-		c.Add(strconv.Itoa(i), i)
+		c.Set(strconv.Itoa(i), i)
 	}
 
 	c.ClearEvery(time.Millisecond)
@@ -74,23 +74,23 @@ func TestClearEvery(t *testing.T) {
 }
 
 func TestGet(t *testing.T) {
-	c := New()
-	c.Add("1", 1)
+	c := New[int]()
+	c.Set("1", 1)
 
 	if result, expected := c.Get("1"), 1; !reflect.DeepEqual(result, expected) {
 		t.Errorf("Result for entry '1' was %#v, expected %#v", result, expected)
 	}
 
-	if result := c.Get("2"); result != nil {
-		t.Errorf("Result for entry '2' was %#v, expected nil", result)
+	if result := c.Get("2"); result != 0 {
+		t.Errorf("Result for entry '2' was %#v, expected 0", result)
 	}
 }
 
-func TestGetf(t *testing.T) {
-	c := New()
-	c.Add("1", 1)
+func TestGetOK(t *testing.T) {
+	c := New[int]()
+	c.Set("1", 1)
 
-	result, exists := c.Getf("1")
+	result, exists := c.GetOK("1")
 	if !exists {
 		t.Error("Entry for key '1' should exist")
 	}
@@ -99,18 +99,18 @@ func TestGetf(t *testing.T) {
 		t.Errorf("Entry for key '1' was %#v, expected %#v", result, expected)
 	}
 
-	if _, exists := c.Getf("2"); exists {
+	if _, exists := c.GetOK("2"); exists {
 		t.Errorf("Entry for key '2' should not exist")
 	}
 }
 
 func TestItems(t *testing.T) {
-	c := New()
+	c := New[int]()
 	for i := 0; i < 5; i++ {
-		c.Add(strconv.Itoa(i), i)
+		c.Set(strconv.Itoa(i), i)
 	}
 
-	expected := map[string]interface{}{
+	expected := map[string]int{
 		"0": 0,
 		"1": 1,
 		"2": 2,
@@ -124,9 +124,9 @@ func TestItems(t *testing.T) {
 }
 
 func TestKeys(t *testing.T) {
-	c := New()
+	c := New[int]()
 	for i := 0; i < 5; i++ {
-		c.Add(strconv.Itoa(i), i)
+		c.Set(strconv.Itoa(i), i)
 	}
 
 	expected := []string{"0", "1", "2", "3", "4"}
@@ -135,12 +135,53 @@ func TestKeys(t *testing.T) {
 	}
 }
 
+func TestSetCapacityLRU(t *testing.T) {
+	c := New[int](WithCapacity[int](2, NewLRUPolicy()))
+	c.Set("1", 1)
+	c.Set("2", 2)
+	c.Get("1") // touch "1" so "2" becomes the least recently used
+	c.Set("3", 3)
+
+	if _, exists := c.GetOK("2"); exists {
+		t.Error("Entry for key '2' should have been evicted")
+	}
+
+	if _, exists := c.GetOK("1"); !exists {
+		t.Error("Entry for key '1' should still exist")
+	}
+
+	if _, exists := c.GetOK("3"); !exists {
+		t.Error("Entry for key '3' should still exist")
+	}
+}
+
+func TestSetCapacityLFU(t *testing.T) {
+	c := New[int](WithCapacity[int](2, NewLFUPolicy()))
+	c.Set("1", 1)
+	c.Set("2", 2)
+	c.Get("1")
+	c.Get("1") // "1" is now accessed more frequently than "2"
+	c.Set("3", 3)
+
+	if _, exists := c.GetOK("2"); exists {
+		t.Error("Entry for key '2' should have been evicted")
+	}
+
+	if _, exists := c.GetOK("1"); !exists {
+		t.Error("Entry for key '1' should still exist")
+	}
+
+	if _, exists := c.GetOK("3"); !exists {
+		t.Error("Entry for key '3' should still exist")
+	}
+}
+
 func benchmarkAdd(count int, b *testing.B) {
 	for n := 0; n < b.N; n++ {
-		c := New()
+		c := New[int]()
 
 		for i := 0; i < count; i++ {
-			c.Add(strconv.Itoa(i), i)
+			c.Set(strconv.Itoa(i), i)
 		}
 	}
 }
@@ -152,9 +193,9 @@ func BenchmarkAdd1000(b *testing.B)  { benchmarkAdd(1000, b) }
 func BenchmarkAdd10000(b *testing.B) { benchmarkAdd(10000, b) }
 
 func benchmarkDelete(count int, b *testing.B) {
-	c := New()
+	c := New[int]()
 	for i := 0; i < count; i++ {
-		c.Add(strconv.Itoa(i), i)
+		c.Set(strconv.Itoa(i), i)
 	}
 
 	for n := 0; n < b.N; n++ {
@@ -170,16 +211,15 @@ func BenchmarkDelete100(b *testing.B)   { benchmarkDelete(100, b) }
 func BenchmarkDelete1000(b *testing.B)  { benchmarkDelete(1000, b) }
 func BenchmarkDelete10000(b *testing.B) { benchmarkDelete(10000, b) }
 
-var result interface{}
+var result int
 
 func benchmarkGet(count int, b *testing.B) {
-	c := New()
+	c := New[int]()
 	for i := 0; i < count; i++ {
-		c.Add(strconv.Itoa(i), i)
+		c.Set(strconv.Itoa(i), i)
 	}
 
-	// avoid compiler optimizations
-	var v interface{}
+	var v int
 	for n := 0; n < b.N; n++ {
 		for i := 0; i < count; i++ {
 			v = c.Get(strconv.Itoa(i))
@@ -0,0 +1,133 @@
+package cache
+
+// An EvictReason describes why an entry was removed from the cache.
+type EvictReason int
+
+const (
+	// EvictReasonDeleted indicates the entry was removed by an explicit
+	// Delete call.
+	EvictReasonDeleted EvictReason = iota
+	// EvictReasonCleared indicates the entry was removed by a Clear call.
+	EvictReasonCleared
+	// EvictReasonExpired indicates the entry's TTL, set via the Expire
+	// SetOption, elapsed.
+	EvictReasonExpired
+	// EvictReasonCapacity indicates the entry was evicted by a capacity
+	// Policy (see WithCapacity).
+	EvictReasonCapacity
+)
+
+func (r EvictReason) String() string {
+	switch r {
+	case EvictReasonDeleted:
+		return "deleted"
+	case EvictReasonCleared:
+		return "cleared"
+	case EvictReasonExpired:
+		return "expired"
+	case EvictReasonCapacity:
+		return "capacity"
+	default:
+		return "unknown"
+	}
+}
+
+// cacheEvent carries a single insertion or eviction notification from the
+// itemOps goroutine to the dispatcher goroutine started by New.
+type cacheEvent[T any] struct {
+	key       string
+	val       T
+	reason    EvictReason
+	isEvicted bool
+}
+
+// loopEvents reads insertion and eviction notifications off c.events and
+// hands each one to its own goroutine for delivery. It must never invoke a
+// subscriber callback itself: callbacks are free to call back into the
+// Cache (e.g. Get, Set), which round-trips through itemOps/inflightOps, and
+// those goroutines are themselves blocked sending the very events this loop
+// is reading. If this loop called callbacks inline, a reentrant or slow
+// subscriber (or simply more than eventBufferSize events published by one
+// op, e.g. a large Clear) would wedge the whole cache. Because dispatch is
+// fully asynchronous, there is no ordering guarantee between events, or
+// between subscribers for the same event.
+func (c *Cache[T]) loopEvents() {
+	for ev := range c.events {
+		go c.dispatchEvent(ev)
+	}
+}
+
+func (c *Cache[T]) dispatchEvent(ev cacheEvent[T]) {
+	if ev.isEvicted {
+		c.subMu.Lock()
+		subs := make([]func(string, T, EvictReason), 0, len(c.evictionSubs))
+		for _, fn := range c.evictionSubs {
+			subs = append(subs, fn)
+		}
+		c.subMu.Unlock()
+
+		for _, fn := range subs {
+			fn(ev.key, ev.val, ev.reason)
+		}
+
+		return
+	}
+
+	c.subMu.Lock()
+	subs := make([]func(string, T), 0, len(c.insertionSubs))
+	for _, fn := range c.insertionSubs {
+		subs = append(subs, fn)
+	}
+	c.subMu.Unlock()
+
+	for _, fn := range subs {
+		fn(ev.key, ev.val)
+	}
+}
+
+// publishInsertion queues an insertion notification. Must be called from
+// within an itemOps op.
+func (c *Cache[T]) publishInsertion(key string, val T) {
+	c.events <- cacheEvent[T]{key: key, val: val}
+}
+
+// publishEviction queues an eviction notification. Must be called from
+// within an itemOps op.
+func (c *Cache[T]) publishEviction(key string, val T, reason EvictReason) {
+	c.events <- cacheEvent[T]{key: key, val: val, reason: reason, isEvicted: true}
+}
+
+// OnEviction registers fn to be called whenever an entry is removed from the
+// cache, whether by Delete, Clear, TTL expiry, or capacity-based eviction.
+// It returns a function that unregisters fn; calling it more than once is a
+// no-op.
+func (c *Cache[T]) OnEviction(fn func(key string, val T, reason EvictReason)) func() {
+	c.subMu.Lock()
+	id := c.nextSubID
+	c.nextSubID++
+	c.evictionSubs[id] = fn
+	c.subMu.Unlock()
+
+	return func() {
+		c.subMu.Lock()
+		delete(c.evictionSubs, id)
+		c.subMu.Unlock()
+	}
+}
+
+// OnInsertion registers fn to be called whenever a new key is added to the
+// cache. Overwriting an existing key via Set does not trigger fn. It returns
+// a function that unregisters fn; calling it more than once is a no-op.
+func (c *Cache[T]) OnInsertion(fn func(key string, val T)) func() {
+	c.subMu.Lock()
+	id := c.nextSubID
+	c.nextSubID++
+	c.insertionSubs[id] = fn
+	c.subMu.Unlock()
+
+	return func() {
+		c.subMu.Lock()
+		delete(c.insertionSubs, id)
+		c.subMu.Unlock()
+	}
+}
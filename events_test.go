@@ -0,0 +1,133 @@
+package cache
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestOnInsertion(t *testing.T) {
+	c := New[int]()
+
+	var mu sync.Mutex
+	var keys []string
+	done := make(chan struct{}, 1)
+	c.OnInsertion(func(key string, val int) {
+		mu.Lock()
+		keys = append(keys, key)
+		mu.Unlock()
+		done <- struct{}{}
+	})
+
+	c.Set("1", 1)
+	<-done
+
+	c.Set("1", 2) // overwrite should not trigger another insertion event
+	c.Set("2", 2)
+	<-done
+
+	mu.Lock()
+	defer mu.Unlock()
+	if result, expected := len(keys), 2; result != expected {
+		t.Errorf("Got %d insertion events, expected %d: %v", result, expected, keys)
+	}
+}
+
+func TestOnEviction(t *testing.T) {
+	c := New[int]()
+
+	type event struct {
+		key    string
+		val    int
+		reason EvictReason
+	}
+
+	events := make(chan event, 1)
+	c.OnEviction(func(key string, val int, reason EvictReason) {
+		events <- event{key, val, reason}
+	})
+
+	c.Set("1", 1)
+	c.Delete("1")
+
+	select {
+	case ev := <-events:
+		if ev.key != "1" || ev.val != 1 || ev.reason != EvictReasonDeleted {
+			t.Errorf("Got event %+v, expected key=1 val=1 reason=deleted", ev)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for eviction event")
+	}
+}
+
+func TestOnEvictionUnsubscribe(t *testing.T) {
+	c := New[int]()
+
+	calls := make(chan struct{}, 10)
+	unsubscribe := c.OnEviction(func(key string, val int, reason EvictReason) {
+		calls <- struct{}{}
+	})
+
+	unsubscribe()
+
+	c.Set("1", 1)
+	c.Delete("1")
+
+	// Give the dispatcher a chance to run; it should have nothing to deliver.
+	c.Set("2", 2)
+	c.Delete("2")
+
+	select {
+	case <-calls:
+		t.Error("Unsubscribed callback should not have been called")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// TestOnEvictionReentrant guards against the dispatcher blocking itemOps: a
+// subscriber that calls back into the cache, combined with enough events to
+// fill c.events, must not wedge the cache.
+func TestOnEvictionReentrant(t *testing.T) {
+	c := New[int]()
+
+	c.OnEviction(func(key string, val int, reason EvictReason) {
+		c.Get("x")
+	})
+
+	const keys = eventBufferSize * 2
+	for i := 0; i < keys; i++ {
+		c.Set(strconv.Itoa(i), i)
+	}
+
+	c.Clear()
+
+	done := make(chan Stats, 1)
+	go func() { done <- c.Stats() }()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Stats() deadlocked after a reentrant OnEviction subscriber")
+	}
+}
+
+func TestOnEvictionExpired(t *testing.T) {
+	c := New[int]()
+
+	events := make(chan EvictReason, 1)
+	c.OnEviction(func(key string, val int, reason EvictReason) {
+		events <- reason
+	})
+
+	c.Set("1", 1, Expire[int](time.Millisecond))
+
+	select {
+	case reason := <-events:
+		if reason != EvictReasonExpired {
+			t.Errorf("Got reason %v, expected %v", reason, EvictReasonExpired)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for eviction event")
+	}
+}
@@ -0,0 +1,115 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// inflightCall represents a single in-flight GetOrLoad call for a key.
+// Followers block on done, which the leader closes once val/err are set.
+type inflightCall[T any] struct {
+	done chan struct{}
+	val  T
+	err  error
+}
+
+// GetOrLoad retrieves the entry at key, or, if absent, invokes loader to
+// produce it. Concurrent calls for the same key that miss the cache share a
+// single loader invocation: one caller runs loader while the others block
+// and receive its result. The time.Duration returned by loader is applied as
+// an Expire TTL on the loaded value; a zero duration means no expiry.
+func (c *Cache[T]) GetOrLoad(key string, loader func(ctx context.Context, key string) (T, time.Duration, error)) (T, error) {
+	type join struct {
+		val      T
+		hit      bool
+		call     *inflightCall[T]
+		isLeader bool
+	}
+
+	result := make(chan join, 1)
+	c.inflightOps <- func(items map[string]T, inflight map[string]*inflightCall[T], stats *cacheStats) {
+		if v, ok := items[key]; ok {
+			stats.hits++
+			if c.policy != nil {
+				c.policy.RecordAccess(key)
+			}
+
+			result <- join{val: v, hit: true}
+			return
+		}
+
+		stats.misses++
+
+		if call, ok := inflight[key]; ok {
+			result <- join{call: call}
+			return
+		}
+
+		call := &inflightCall[T]{done: make(chan struct{})}
+		inflight[key] = call
+		result <- join{call: call, isLeader: true}
+	}
+
+	j := <-result
+	if j.hit {
+		return j.val, nil
+	}
+
+	if !j.isLeader {
+		<-j.call.done
+		return j.call.val, j.call.err
+	}
+
+	val, ttl, err := runLoader(key, loader)
+	j.call.val, j.call.err = val, err
+
+	if err == nil && ttl <= 0 {
+		// As in Set, drop any expiry timer left over from an earlier entry at
+		// key so it cannot remove the freshly loaded, non-expiring value.
+		c.expiryOps <- func(expiries map[string]*expiryEntry) {
+			if e, ok := expiries[key]; ok {
+				e.timer.Stop()
+				delete(expiries, key)
+			}
+		}
+	}
+
+	done := make(chan struct{})
+	c.inflightOps <- func(items map[string]T, inflight map[string]*inflightCall[T], stats *cacheStats) {
+		delete(inflight, key)
+
+		if err == nil {
+			c.insert(items, stats, key, val)
+		}
+
+		close(done)
+	}
+	<-done
+
+	close(j.call.done)
+
+	if err != nil {
+		return val, err
+	}
+
+	if ttl > 0 {
+		Expire[T](ttl)(c, key, val)
+	}
+
+	return val, nil
+}
+
+// runLoader invokes loader, converting a panic into an error instead of
+// letting it unwind past GetOrLoad. Without this, a panicking loader would
+// skip the cleanup that runs after it returns, leaving key wedged in
+// inflight and every follower blocked on call.done forever.
+func runLoader[T any](key string, loader func(ctx context.Context, key string) (T, time.Duration, error)) (val T, ttl time.Duration, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("cache: loader for key %q panicked: %v", key, r)
+		}
+	}()
+
+	return loader(context.Background(), key)
+}
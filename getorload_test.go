@@ -0,0 +1,178 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetOrLoad(t *testing.T) {
+	c := New[int]()
+
+	var calls int32
+	loader := func(ctx context.Context, key string) (int, time.Duration, error) {
+		atomic.AddInt32(&calls, 1)
+		return 1, 0, nil
+	}
+
+	result, err := c.GetOrLoad("1", loader)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result != 1 {
+		t.Errorf("Result was %#v, expected 1", result)
+	}
+
+	if result, exists := c.GetOK("1"); !exists || result != 1 {
+		t.Errorf("Entry for key '1' should be cached as 1, got %#v (exists=%v)", result, exists)
+	}
+
+	if _, err := c.GetOrLoad("1", loader); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result := atomic.LoadInt32(&calls); result != 1 {
+		t.Errorf("Loader was called %d times, expected 1", result)
+	}
+}
+
+func TestGetOrLoadCoalesces(t *testing.T) {
+	c := New[int]()
+
+	var calls int32
+	release := make(chan struct{})
+	loader := func(ctx context.Context, key string) (int, time.Duration, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return 42, 0, nil
+	}
+
+	const callers = 10
+	var wg sync.WaitGroup
+	results := make([]int, callers)
+	wg.Add(callers)
+	for i := 0; i < callers; i++ {
+		go func(i int) {
+			defer wg.Done()
+			v, err := c.GetOrLoad("1", loader)
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			results[i] = v
+		}(i)
+	}
+
+	time.Sleep(10 * time.Millisecond) // let callers pile up behind the loader
+	close(release)
+	wg.Wait()
+
+	if result := atomic.LoadInt32(&calls); result != 1 {
+		t.Errorf("Loader was called %d times, expected 1", result)
+	}
+
+	for i, v := range results {
+		if v != 42 {
+			t.Errorf("Caller %d got %#v, expected 42", i, v)
+		}
+	}
+}
+
+func TestGetOrLoadError(t *testing.T) {
+	c := New[int]()
+
+	wantErr := errors.New("boom")
+	loader := func(ctx context.Context, key string) (int, time.Duration, error) {
+		return 0, 0, wantErr
+	}
+
+	if _, err := c.GetOrLoad("1", loader); !errors.Is(err, wantErr) {
+		t.Errorf("Got error %v, expected %v", err, wantErr)
+	}
+
+	if _, exists := c.GetOK("1"); exists {
+		t.Error("A failed load should not populate the cache")
+	}
+}
+
+func TestGetOrLoadLoaderPanicUnblocksFollowers(t *testing.T) {
+	c := New[int]()
+
+	release := make(chan struct{})
+	loader := func(ctx context.Context, key string) (int, time.Duration, error) {
+		<-release
+		panic("boom")
+	}
+
+	// The first caller becomes the leader and blocks in loader until release
+	// is closed.
+	leaderDone := make(chan error, 1)
+	go func() {
+		_, err := c.GetOrLoad("1", loader)
+		leaderDone <- err
+	}()
+
+	time.Sleep(10 * time.Millisecond) // let the leader register in inflight
+
+	// A follower joins the same in-flight call instead of running its own
+	// loader.
+	followerDone := make(chan error, 1)
+	go func() {
+		_, err := c.GetOrLoad("1", loader)
+		followerDone <- err
+	}()
+
+	time.Sleep(10 * time.Millisecond) // let the follower join
+	close(release)
+
+	select {
+	case err := <-leaderDone:
+		if err == nil {
+			t.Error("Expected the leader to get an error after the loader panicked")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Leader never returned after its loader panicked")
+	}
+
+	select {
+	case err := <-followerDone:
+		if err == nil {
+			t.Error("Expected the follower to get an error after the loader panicked")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Follower never unblocked after the leader's loader panicked")
+	}
+
+	// The key must not be left wedged: a subsequent call should be able to
+	// run a fresh loader rather than hanging on stale inflight state.
+	result, err := c.GetOrLoad("1", func(ctx context.Context, key string) (int, time.Duration, error) {
+		return 7, 0, nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result != 7 {
+		t.Errorf("Result was %#v, expected 7", result)
+	}
+}
+
+func TestGetOrLoadExpiry(t *testing.T) {
+	c := New[int]()
+
+	loader := func(ctx context.Context, key string) (int, time.Duration, error) {
+		return 1, time.Millisecond, nil
+	}
+
+	if _, err := c.GetOrLoad("1", loader); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	time.Sleep(time.Millisecond * 2)
+
+	if _, exists := c.GetOK("1"); exists {
+		t.Error("Entry for key '1' should have expired by now")
+	}
+}
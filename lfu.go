@@ -0,0 +1,113 @@
+package cache
+
+import "container/list"
+
+// lfuFreqNode groups the keys that currently share freq accesses. freqNodes
+// are kept in LFUPolicy.freqs in ascending order of freq.
+type lfuFreqNode struct {
+	freq uint64
+	keys *list.List
+}
+
+// LFUPolicy is a Policy that evicts the least frequently used key, breaking
+// ties by least recent use within the lowest frequency. Access tracking is
+// O(1): freqs holds one node per distinct access count, and each node holds
+// the keys currently at that count.
+type LFUPolicy struct {
+	freqs    *list.List
+	freqNode map[string]*list.Element // key -> its node in freqs
+	keyElem  map[string]*list.Element // key -> its element in that node's keys list
+}
+
+// NewLFUPolicy returns a Policy that evicts the least frequently used key.
+func NewLFUPolicy() *LFUPolicy {
+	return &LFUPolicy{
+		freqs:    list.New(),
+		freqNode: map[string]*list.Element{},
+		keyElem:  map[string]*list.Element{},
+	}
+}
+
+func (p *LFUPolicy) RecordInsert(key string) {
+	p.detach(key)
+
+	front := p.freqs.Front()
+	if front == nil || front.Value.(*lfuFreqNode).freq != 1 {
+		front = p.freqs.PushFront(&lfuFreqNode{freq: 1, keys: list.New()})
+	}
+
+	node := front.Value.(*lfuFreqNode)
+	p.keyElem[key] = node.keys.PushFront(key)
+	p.freqNode[key] = front
+}
+
+func (p *LFUPolicy) RecordAccess(key string) {
+	curEl, ok := p.freqNode[key]
+	if !ok {
+		p.RecordInsert(key)
+		return
+	}
+
+	cur := curEl.Value.(*lfuFreqNode)
+	cur.keys.Remove(p.keyElem[key])
+
+	next := curEl.Next()
+	if next == nil || next.Value.(*lfuFreqNode).freq != cur.freq+1 {
+		next = p.freqs.InsertAfter(&lfuFreqNode{freq: cur.freq + 1, keys: list.New()}, curEl)
+	}
+
+	nextNode := next.Value.(*lfuFreqNode)
+	p.keyElem[key] = nextNode.keys.PushFront(key)
+	p.freqNode[key] = next
+
+	if cur.keys.Len() == 0 {
+		p.freqs.Remove(curEl)
+	}
+}
+
+func (p *LFUPolicy) Remove(key string) {
+	p.detach(key)
+}
+
+func (p *LFUPolicy) Clear() {
+	p.freqs.Init()
+	p.freqNode = map[string]*list.Element{}
+	p.keyElem = map[string]*list.Element{}
+}
+
+func (p *LFUPolicy) Evict() (string, bool) {
+	freqEl := p.freqs.Front()
+	if freqEl == nil {
+		return "", false
+	}
+
+	node := freqEl.Value.(*lfuFreqNode)
+	keyEl := node.keys.Back()
+	key := keyEl.Value.(string)
+
+	node.keys.Remove(keyEl)
+	if node.keys.Len() == 0 {
+		p.freqs.Remove(freqEl)
+	}
+
+	delete(p.keyElem, key)
+	delete(p.freqNode, key)
+	return key, true
+}
+
+// detach removes key from whichever freqNode currently holds it, if any.
+func (p *LFUPolicy) detach(key string) {
+	freqEl, ok := p.freqNode[key]
+	if !ok {
+		return
+	}
+
+	node := freqEl.Value.(*lfuFreqNode)
+	node.keys.Remove(p.keyElem[key])
+	if node.keys.Len() == 0 {
+		p.freqs.Remove(freqEl)
+	}
+
+	delete(p.keyElem, key)
+	delete(p.freqNode, key)
+}
@@ -0,0 +1,92 @@
+package cache
+
+import "testing"
+
+func TestLFUPolicyEvictTiesByRecency(t *testing.T) {
+	p := NewLFUPolicy()
+	p.RecordInsert("a")
+	p.RecordInsert("b")
+	p.RecordInsert("c")
+
+	// All three share freq 1; the least recently inserted should go first.
+	if key, ok := p.Evict(); !ok || key != "a" {
+		t.Errorf("Evict() = %q, %v, expected \"a\", true", key, ok)
+	}
+
+	if key, ok := p.Evict(); !ok || key != "b" {
+		t.Errorf("Evict() = %q, %v, expected \"b\", true", key, ok)
+	}
+}
+
+func TestLFUPolicyRemoveFromNonHeadNode(t *testing.T) {
+	p := NewLFUPolicy()
+	p.RecordInsert("c") // stays at freq 1, keeping that node from emptying
+	p.RecordInsert("a")
+	p.RecordInsert("b")
+	p.RecordAccess("a") // a -> freq 2
+	p.RecordAccess("b") // b -> freq 2, pushed in front of a
+
+	// freq 1 (holding "c") is now the head node; freq 2 (holding "b", "a") is
+	// not. Within freq 2, "a" is at the back, not the head.
+	p.Remove("a")
+
+	if _, ok := p.freqNode["a"]; ok {
+		t.Error("\"a\" should no longer be tracked in freqNode after Remove")
+	}
+	if _, ok := p.keyElem["a"]; ok {
+		t.Error("\"a\" should no longer be tracked in keyElem after Remove")
+	}
+
+	node := p.freqNode["b"].Value.(*lfuFreqNode)
+	if node.keys.Len() != 1 {
+		t.Errorf("freq 2 node has %d keys, expected 1 (just \"b\")", node.keys.Len())
+	}
+
+	key, ok := p.Evict()
+	if !ok || key != "c" {
+		t.Errorf("Evict() = %q, %v, expected \"c\", true", key, ok)
+	}
+}
+
+func TestLFUPolicyFreqNodeCleanup(t *testing.T) {
+	p := NewLFUPolicy()
+	p.RecordInsert("a")
+
+	if p.freqs.Len() != 1 {
+		t.Fatalf("freqs.Len() = %d, expected 1 after RecordInsert", p.freqs.Len())
+	}
+
+	p.RecordAccess("a") // moves "a" out of the freq-1 node, emptying it
+
+	if p.freqs.Len() != 1 {
+		t.Fatalf("freqs.Len() = %d, expected 1 after the freq-1 node empties", p.freqs.Len())
+	}
+
+	front := p.freqs.Front().Value.(*lfuFreqNode)
+	if front.freq != 2 {
+		t.Errorf("remaining node has freq %d, expected 2 (the emptied freq-1 node should be gone)", front.freq)
+	}
+}
+
+func TestLFUPolicyClear(t *testing.T) {
+	p := NewLFUPolicy()
+	p.RecordInsert("a")
+	p.RecordInsert("b")
+	p.RecordAccess("a")
+
+	p.Clear()
+
+	if p.freqs.Len() != 0 {
+		t.Errorf("freqs.Len() = %d, expected 0 after Clear", p.freqs.Len())
+	}
+	if len(p.freqNode) != 0 {
+		t.Errorf("freqNode has %d entries, expected 0 after Clear", len(p.freqNode))
+	}
+	if len(p.keyElem) != 0 {
+		t.Errorf("keyElem has %d entries, expected 0 after Clear", len(p.keyElem))
+	}
+
+	if _, ok := p.Evict(); ok {
+		t.Error("Evict() after Clear should report ok=false")
+	}
+}
@@ -0,0 +1,54 @@
+package cache
+
+import "container/list"
+
+// LRUPolicy is a Policy that evicts the least recently used key.
+type LRUPolicy struct {
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+// NewLRUPolicy returns a Policy that evicts the least recently used key.
+func NewLRUPolicy() *LRUPolicy {
+	return &LRUPolicy{
+		order:   list.New(),
+		entries: map[string]*list.Element{},
+	}
+}
+
+func (p *LRUPolicy) RecordInsert(key string) {
+	p.RecordAccess(key)
+}
+
+func (p *LRUPolicy) RecordAccess(key string) {
+	if el, ok := p.entries[key]; ok {
+		p.order.MoveToFront(el)
+		return
+	}
+
+	p.entries[key] = p.order.PushFront(key)
+}
+
+func (p *LRUPolicy) Remove(key string) {
+	if el, ok := p.entries[key]; ok {
+		p.order.Remove(el)
+		delete(p.entries, key)
+	}
+}
+
+func (p *LRUPolicy) Clear() {
+	p.order.Init()
+	p.entries = map[string]*list.Element{}
+}
+
+func (p *LRUPolicy) Evict() (string, bool) {
+	el := p.order.Back()
+	if el == nil {
+		return "", false
+	}
+
+	key := el.Value.(string)
+	p.order.Remove(el)
+	delete(p.entries, key)
+	return key, true
+}
@@ -0,0 +1,133 @@
+// Package metrics adapts a Cache's Stats to external metrics libraries
+// (Prometheus, OpenTelemetry, etc.) without the core cache package taking a
+// dependency on any of them.
+package metrics
+
+import (
+	"time"
+
+	cache "github.com/ridgelines/go-cache"
+)
+
+// A Gauge is satisfied by most metrics libraries' gauge type, e.g.
+// prometheus.Gauge.
+type Gauge interface {
+	Set(value float64)
+}
+
+// A Counter is satisfied by most metrics libraries' counter type, e.g.
+// prometheus.Counter. Collector only ever Adds non-negative deltas.
+type Counter interface {
+	Add(delta float64)
+}
+
+// A StatsSource is anything Collector can read activity counters from;
+// *cache.Cache[T] and *cache.Sharded[T] both satisfy it for any T.
+type StatsSource interface {
+	Stats() cache.Stats
+}
+
+// A Collector periodically reads a StatsSource's Stats and reports them to
+// registered Counters and Gauges. Counters are reported as deltas since the
+// previous Collect call, since Stats holds cumulative totals. A Collector is
+// not safe for concurrent use; call Collect (or Every) from a single
+// goroutine.
+type Collector struct {
+	source StatsSource
+	prev   cache.Stats
+
+	hits, misses, insertions, evictions Counter
+	expirations                         Counter
+	size                                Gauge
+}
+
+// NewCollector returns a Collector that reads Stats from source.
+func NewCollector(source StatsSource) *Collector {
+	return &Collector{source: source}
+}
+
+// WithHits registers a Counter incremented by new cache hits on each Collect.
+func (c *Collector) WithHits(counter Counter) *Collector {
+	c.hits = counter
+	return c
+}
+
+// WithMisses registers a Counter incremented by new cache misses on each
+// Collect.
+func (c *Collector) WithMisses(counter Counter) *Collector {
+	c.misses = counter
+	return c
+}
+
+// WithInsertions registers a Counter incremented by new insertions on each
+// Collect.
+func (c *Collector) WithInsertions(counter Counter) *Collector {
+	c.insertions = counter
+	return c
+}
+
+// WithEvictions registers a Counter incremented by new evictions, of any
+// reason, on each Collect.
+func (c *Collector) WithEvictions(counter Counter) *Collector {
+	c.evictions = counter
+	return c
+}
+
+// WithExpirations registers a Counter incremented by new TTL expirations on
+// each Collect.
+func (c *Collector) WithExpirations(counter Counter) *Collector {
+	c.expirations = counter
+	return c
+}
+
+// WithSize registers a Gauge set to the cache's current entry count on each
+// Collect.
+func (c *Collector) WithSize(gauge Gauge) *Collector {
+	c.size = gauge
+	return c
+}
+
+// Collect reads the source's current Stats and reports them to every
+// registered Counter and Gauge.
+func (c *Collector) Collect() {
+	stats := c.source.Stats()
+
+	if c.hits != nil {
+		c.hits.Add(float64(stats.Hits - c.prev.Hits))
+	}
+
+	if c.misses != nil {
+		c.misses.Add(float64(stats.Misses - c.prev.Misses))
+	}
+
+	if c.insertions != nil {
+		c.insertions.Add(float64(stats.Insertions - c.prev.Insertions))
+	}
+
+	if c.evictions != nil {
+		c.evictions.Add(float64(stats.Evictions - c.prev.Evictions))
+	}
+
+	if c.expirations != nil {
+		c.expirations.Add(float64(stats.Expirations - c.prev.Expirations))
+	}
+
+	if c.size != nil {
+		c.size.Set(float64(stats.Size))
+	}
+
+	c.prev = stats
+}
+
+// Every starts calling Collect on a loop at the specified interval,
+// returning the underlying ticker so the caller can Stop it.
+func (c *Collector) Every(d time.Duration) *time.Ticker {
+	ticker := time.NewTicker(d)
+	go func() {
+		for range ticker.C {
+			c.Collect()
+		}
+	}()
+
+	return ticker
+}
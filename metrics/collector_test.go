@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"testing"
+
+	cache "github.com/ridgelines/go-cache"
+)
+
+type fakeCounter struct{ total float64 }
+
+func (f *fakeCounter) Add(delta float64) { f.total += delta }
+
+type fakeGauge struct{ value float64 }
+
+func (f *fakeGauge) Set(value float64) { f.value = value }
+
+func TestCollector(t *testing.T) {
+	c := cache.New[int]()
+
+	hits, misses, insertions, size := &fakeCounter{}, &fakeCounter{}, &fakeCounter{}, &fakeGauge{}
+	collector := NewCollector(c).
+		WithHits(hits).
+		WithMisses(misses).
+		WithInsertions(insertions).
+		WithSize(size)
+
+	c.Set("1", 1)
+	c.Get("1")
+	c.Get("missing")
+	collector.Collect()
+
+	if hits.total != 1 {
+		t.Errorf("hits was %v, expected 1", hits.total)
+	}
+
+	if misses.total != 1 {
+		t.Errorf("misses was %v, expected 1", misses.total)
+	}
+
+	if insertions.total != 1 {
+		t.Errorf("insertions was %v, expected 1", insertions.total)
+	}
+
+	if size.value != 1 {
+		t.Errorf("size was %v, expected 1", size.value)
+	}
+
+	c.Set("2", 2)
+	c.Get("2")
+	collector.Collect()
+
+	if hits.total != 2 {
+		t.Errorf("hits was %v, expected 2 after a second Collect", hits.total)
+	}
+
+	if size.value != 2 {
+		t.Errorf("size was %v, expected 2 after a second Collect", size.value)
+	}
+}
@@ -5,15 +5,31 @@ import "time"
 // A SetOption will perform logic after a set action completes
 type SetOption[T any] func(c *Cache[T], key string, val T)
 
+// A CacheOption configures a Cache at construction time
+type CacheOption[T any] func(c *Cache[T])
+
+// WithCapacity is a CacheOption that bounds the cache to at most n entries.
+// Once Set pushes the cache above capacity, policy selects a victim key to
+// evict.
+func WithCapacity[T any](n uint64, policy Policy) CacheOption[T] {
+	return func(c *Cache[T]) {
+		c.capacity = n
+		c.policy = policy
+	}
+}
+
 // Expire is a SetOption that will cause the entry to expire after the specified duration
 func Expire[T any](expiry time.Duration) SetOption[T] {
 	return func(c *Cache[T], key string, val T) {
-		c.expiryOps <- func(expiries map[string]*time.Timer) {
-			if timer, ok := expiries[key]; ok {
-				timer.Stop()
+		c.expiryOps <- func(expiries map[string]*expiryEntry) {
+			if e, ok := expiries[key]; ok {
+				e.timer.Stop()
 			}
 
-			expiries[key] = time.AfterFunc(expiry, func() { c.Delete(key) })
+			expiries[key] = &expiryEntry{
+				timer: time.AfterFunc(expiry, func() { c.delete(key, EvictReasonExpired) }),
+				at:    time.Now().Add(expiry),
+			}
 		}
 	}
 }
@@ -0,0 +1,102 @@
+package cache
+
+import (
+	"encoding/gob"
+	"io"
+	"os"
+	"time"
+)
+
+// persistedEntry is the on-disk representation of a single cache entry.
+// ExpiresAt is only meaningful when HasTTL is true; a gob.Decoder
+// zero-values missing fields, so HasTTL false is indistinguishable from "no
+// expiry" either way.
+type persistedEntry[T any] struct {
+	Val       T
+	HasTTL    bool
+	ExpiresAt time.Time
+}
+
+// persistedPayload is the on-disk representation of a whole Save call.
+type persistedPayload[T any] struct {
+	Entries map[string]persistedEntry[T]
+}
+
+// Save gob-encodes the cache's current entries to w, along with each entry's
+// absolute expiry time, so Load can work out how much of the TTL is left
+// regardless of how long the payload sits on disk. T must be a type gob can
+// encode; if T is an interface, register its concrete types with
+// gob.Register first.
+func (c *Cache[T]) Save(w io.Writer) error {
+	items := c.Items()
+
+	expiresAt := make(chan map[string]time.Time, 1)
+	c.expiryOps <- func(expiries map[string]*expiryEntry) {
+		result := make(map[string]time.Time, len(expiries))
+		for key, e := range expiries {
+			result[key] = e.at
+		}
+
+		expiresAt <- result
+	}
+	atByKey := <-expiresAt
+
+	payload := persistedPayload[T]{Entries: make(map[string]persistedEntry[T], len(items))}
+	for key, val := range items {
+		at, hasTTL := atByKey[key]
+		payload.Entries[key] = persistedEntry[T]{Val: val, HasTTL: hasTTL, ExpiresAt: at}
+	}
+
+	return gob.NewEncoder(w).Encode(payload)
+}
+
+// Load decodes entries gob-encoded by Save and adds them to the cache.
+// Entries whose remaining TTL, computed against the current time, had
+// already reached zero or below are skipped; surviving TTLs are re-armed via
+// Expire for whatever duration remains.
+func (c *Cache[T]) Load(r io.Reader) error {
+	var payload persistedPayload[T]
+	if err := gob.NewDecoder(r).Decode(&payload); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for key, entry := range payload.Entries {
+		if !entry.HasTTL {
+			c.Set(key, entry.Val)
+			continue
+		}
+
+		remaining := entry.ExpiresAt.Sub(now)
+		if remaining <= 0 {
+			continue
+		}
+
+		c.Set(key, entry.Val, Expire[T](remaining))
+	}
+
+	return nil
+}
+
+// SaveFile is like Save but writes to the file at path, creating or
+// truncating it as needed.
+func (c *Cache[T]) SaveFile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return c.Save(f)
+}
+
+// LoadFile is like Load but reads from the file at path.
+func (c *Cache[T]) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return c.Load(f)
+}
@@ -0,0 +1,97 @@
+package cache
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSaveLoad(t *testing.T) {
+	c := New[int]()
+	c.Set("1", 1)
+	c.Set("2", 2, Expire[int](time.Hour))
+
+	var buf bytes.Buffer
+	if err := c.Save(&buf); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	loaded := New[int]()
+	if err := loaded.Load(&buf); err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	if result, expected := loaded.Get("1"), 1; result != expected {
+		t.Errorf("Entry for key '1' was %#v, expected %#v", result, expected)
+	}
+
+	if result, exists := loaded.GetOK("2"); !exists || result != 2 {
+		t.Errorf("Entry for key '2' was %#v (exists=%v), expected 2", result, exists)
+	}
+}
+
+func TestSaveLoadSkipsExpired(t *testing.T) {
+	c := New[int]()
+	c.Set("1", 1, Expire[int](time.Millisecond))
+
+	time.Sleep(time.Millisecond * 2)
+
+	var buf bytes.Buffer
+	if err := c.Save(&buf); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	loaded := New[int]()
+	if err := loaded.Load(&buf); err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	if _, exists := loaded.GetOK("1"); exists {
+		t.Error("Entry for key '1' should not have been loaded, it expired before Save")
+	}
+}
+
+// TestSaveLoadAccountsForElapsedTime guards against re-arming a TTL that was
+// already mostly spent by the time Load actually ran.
+func TestSaveLoadAccountsForElapsedTime(t *testing.T) {
+	c := New[int]()
+	c.Set("1", 1, Expire[int](200*time.Millisecond))
+
+	var buf bytes.Buffer
+	if err := c.Save(&buf); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	time.Sleep(180 * time.Millisecond)
+
+	loaded := New[int]()
+	if err := loaded.Load(&buf); err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	time.Sleep(100 * time.Millisecond) // 280ms since Set, past the original 200ms TTL
+
+	if _, exists := loaded.GetOK("1"); exists {
+		t.Error("Entry for key '1' should have expired, TTL should run from Set, not from Load")
+	}
+}
+
+func TestSaveFileLoadFile(t *testing.T) {
+	c := New[int]()
+	c.Set("1", 1)
+
+	path := filepath.Join(t.TempDir(), "cache.gob")
+	if err := c.SaveFile(path); err != nil {
+		t.Fatalf("SaveFile returned error: %v", err)
+	}
+
+	loaded := New[int]()
+	if err := loaded.LoadFile(path); err != nil {
+		t.Fatalf("LoadFile returned error: %v", err)
+	}
+
+	if result, expected := loaded.Get("1"), 1; result != expected {
+		t.Errorf("Entry for key '1' was %#v, expected %#v", result, expected)
+	}
+}
@@ -0,0 +1,23 @@
+package cache
+
+// A Policy selects eviction victims for a capacity-bound Cache. A Policy is
+// driven exclusively by its owning Cache's itemOps goroutine, so
+// implementations do not need to be safe for concurrent use.
+type Policy interface {
+	// RecordInsert notes that key was newly added to the cache.
+	RecordInsert(key string)
+
+	// RecordAccess notes that key was read or overwritten in the cache.
+	RecordAccess(key string)
+
+	// Remove discards any bookkeeping held for key, e.g. after a manual
+	// Delete.
+	Remove(key string)
+
+	// Clear discards all bookkeeping, e.g. after Cache.Clear.
+	Clear()
+
+	// Evict selects a victim key to remove when the cache is over capacity.
+	// ok is false if the policy holds no keys to evict.
+	Evict() (key string, ok bool)
+}
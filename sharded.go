@@ -0,0 +1,214 @@
+package cache
+
+import (
+	"context"
+	"hash/fnv"
+	"sort"
+	"sync"
+	"time"
+)
+
+// A Sharded is a thread-safe store for fast item storage and retrieval that
+// partitions its keys across several independent Cache shards. A single
+// Cache funnels every operation through one itemOps goroutine, which caps
+// throughput at a single core; splitting the key space across shards lets
+// operations on different shards proceed concurrently.
+type Sharded[T any] struct {
+	shards []*Cache[T]
+}
+
+// NewSharded returns an empty cache partitioned across n shards. Each shard
+// is an independent Cache constructed with the given options. n is clamped
+// to at least 1.
+func NewSharded[T any](n int, options ...CacheOption[T]) *Sharded[T] {
+	if n < 1 {
+		n = 1
+	}
+
+	s := &Sharded[T]{shards: make([]*Cache[T], n)}
+	for i := range s.shards {
+		s.shards[i] = New(options...)
+	}
+
+	return s
+}
+
+// shardFor returns the shard responsible for key, selected by FNV-1a of key
+// modulo the shard count.
+func (s *Sharded[T]) shardFor(key string) *Cache[T] {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return s.shards[h.Sum32()%uint32(len(s.shards))]
+}
+
+// Set will set the val into the cache at the specified key.
+// If an entry already exists at the specified key, it will be overwritten.
+// The options param can be used to perform logic after the entry has be inserted.
+func (s *Sharded[T]) Set(key string, val T, options ...SetOption[T]) {
+	s.shardFor(key).Set(key, val, options...)
+}
+
+// Get retrieves an entry at the specified key
+func (s *Sharded[T]) Get(key string) T {
+	return s.shardFor(key).Get(key)
+}
+
+// GetOK retrieves an entry at the specified key.
+// Returns bool specifying if the entry exists
+func (s *Sharded[T]) GetOK(key string) (T, bool) {
+	return s.shardFor(key).GetOK(key)
+}
+
+// GetOrLoad retrieves the entry at key, or, if absent, invokes loader to
+// produce it. Concurrent misses for the same key are coalesced within the
+// key's owning shard; see Cache.GetOrLoad.
+func (s *Sharded[T]) GetOrLoad(key string, loader func(ctx context.Context, key string) (T, time.Duration, error)) (T, error) {
+	return s.shardFor(key).GetOrLoad(key, loader)
+}
+
+// Delete removes an entry from the cache at the specified key.
+// If no entry exists at the specified key, no action is taken
+func (s *Sharded[T]) Delete(key string) {
+	s.shardFor(key).Delete(key)
+}
+
+// Clear removes all entries from the cache
+func (s *Sharded[T]) Clear() {
+	var wg sync.WaitGroup
+	wg.Add(len(s.shards))
+	for _, shard := range s.shards {
+		shard := shard
+		go func() {
+			defer wg.Done()
+			shard.Clear()
+		}()
+	}
+
+	wg.Wait()
+}
+
+// ClearEvery clears the cache on a loop at the specified interval
+func (s *Sharded[T]) ClearEvery(d time.Duration) *time.Ticker {
+	ticker := time.NewTicker(d)
+	go func() {
+		for range ticker.C {
+			s.Clear()
+		}
+	}()
+
+	return ticker
+}
+
+// Keys retrieves a sorted list of all keys in the cache. Shards are queried
+// in parallel and the results merged.
+func (s *Sharded[T]) Keys() []string {
+	perShard := make([][]string, len(s.shards))
+	var wg sync.WaitGroup
+	wg.Add(len(s.shards))
+	for i, shard := range s.shards {
+		i, shard := i, shard
+		go func() {
+			defer wg.Done()
+			perShard[i] = shard.Keys()
+		}()
+	}
+	wg.Wait()
+
+	keys := make([]string, 0)
+	for _, shardKeys := range perShard {
+		keys = append(keys, shardKeys...)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// Items retrieves all entries in the cache. Shards are queried in parallel
+// and the results merged.
+func (s *Sharded[T]) Items() map[string]T {
+	perShard := make([]map[string]T, len(s.shards))
+	var wg sync.WaitGroup
+	wg.Add(len(s.shards))
+	for i, shard := range s.shards {
+		i, shard := i, shard
+		go func() {
+			defer wg.Done()
+			perShard[i] = shard.Items()
+		}()
+	}
+	wg.Wait()
+
+	items := map[string]T{}
+	for _, shardItems := range perShard {
+		for key, val := range shardItems {
+			items[key] = val
+		}
+	}
+
+	return items
+}
+
+// OnEviction registers fn on every shard, so it is called whenever an entry
+// is removed from any of them. It returns a function that unregisters fn
+// from every shard.
+func (s *Sharded[T]) OnEviction(fn func(key string, val T, reason EvictReason)) func() {
+	unsubscribes := make([]func(), len(s.shards))
+	for i, shard := range s.shards {
+		unsubscribes[i] = shard.OnEviction(fn)
+	}
+
+	return func() {
+		for _, unsubscribe := range unsubscribes {
+			unsubscribe()
+		}
+	}
+}
+
+// OnInsertion registers fn on every shard, so it is called whenever a new
+// key is added to any of them. It returns a function that unregisters fn
+// from every shard.
+func (s *Sharded[T]) OnInsertion(fn func(key string, val T)) func() {
+	unsubscribes := make([]func(), len(s.shards))
+	for i, shard := range s.shards {
+		unsubscribes[i] = shard.OnInsertion(fn)
+	}
+
+	return func() {
+		for _, unsubscribe := range unsubscribes {
+			unsubscribe()
+		}
+	}
+}
+
+// Stats returns the sum of every shard's activity counters.
+func (s *Sharded[T]) Stats() Stats {
+	total := Stats{EvictionsByReason: map[EvictReason]uint64{}}
+	for _, shard := range s.shards {
+		shardStats := shard.Stats()
+
+		total.Hits += shardStats.Hits
+		total.Misses += shardStats.Misses
+		total.Insertions += shardStats.Insertions
+		total.Expirations += shardStats.Expirations
+		total.Evictions += shardStats.Evictions
+		total.Size += shardStats.Size
+
+		for reason, n := range shardStats.EvictionsByReason {
+			total.EvictionsByReason[reason] += n
+		}
+	}
+
+	return total
+}
+
+// HitRatio returns Stats().Hits / (Stats().Hits + Stats().Misses), or 0 if
+// the cache has not yet seen a Get, GetOK or GetOrLoad call.
+func (s *Sharded[T]) HitRatio() float64 {
+	stats := s.Stats()
+	total := stats.Hits + stats.Misses
+	if total == 0 {
+		return 0
+	}
+
+	return float64(stats.Hits) / float64(total)
+}
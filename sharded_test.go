@@ -0,0 +1,160 @@
+package cache
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestShardedSetGet(t *testing.T) {
+	c := NewSharded[int](8)
+	for i := 0; i < 100; i++ {
+		c.Set(strconv.Itoa(i), i)
+	}
+
+	for i := 0; i < 100; i++ {
+		if result, expected := c.Get(strconv.Itoa(i)), i; result != expected {
+			t.Errorf("Result for entry '%d' was %#v, expected %#v", i, result, expected)
+		}
+	}
+}
+
+func TestShardedGetOK(t *testing.T) {
+	c := NewSharded[int](8)
+	c.Set("1", 1)
+
+	if result, exists := c.GetOK("1"); !exists || result != 1 {
+		t.Errorf("Entry for key '1' was %#v (exists=%v), expected 1", result, exists)
+	}
+
+	if _, exists := c.GetOK("2"); exists {
+		t.Error("Entry for key '2' should not exist")
+	}
+}
+
+func TestShardedDelete(t *testing.T) {
+	c := NewSharded[int](8)
+	c.Set("1", 1)
+	c.Delete("1")
+
+	if _, exists := c.GetOK("1"); exists {
+		t.Error("Entry for key '1' should not exist")
+	}
+}
+
+func TestShardedClear(t *testing.T) {
+	c := NewSharded[int](8)
+	for i := 0; i < 100; i++ {
+		c.Set(strconv.Itoa(i), i)
+	}
+
+	c.Clear()
+
+	if keys := c.Keys(); len(keys) != 0 {
+		t.Errorf("Cache should have been empty, had keys: %v", keys)
+	}
+}
+
+func TestShardedKeys(t *testing.T) {
+	c := NewSharded[int](8)
+	for i := 0; i < 5; i++ {
+		c.Set(strconv.Itoa(i), i)
+	}
+
+	expected := []string{"0", "1", "2", "3", "4"}
+	if result := c.Keys(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Result was %#v, expected %#v", result, expected)
+	}
+}
+
+func TestShardedItems(t *testing.T) {
+	c := NewSharded[int](8)
+	for i := 0; i < 5; i++ {
+		c.Set(strconv.Itoa(i), i)
+	}
+
+	expected := map[string]int{
+		"0": 0,
+		"1": 1,
+		"2": 2,
+		"3": 3,
+		"4": 4,
+	}
+
+	if result := c.Items(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Result was %#v, expected %#v", result, expected)
+	}
+}
+
+func TestShardedOnEviction(t *testing.T) {
+	c := NewSharded[int](8)
+
+	events := make(chan EvictReason, 1)
+	unsubscribe := c.OnEviction(func(key string, val int, reason EvictReason) {
+		events <- reason
+	})
+	defer unsubscribe()
+
+	c.Set("1", 1)
+	c.Delete("1")
+
+	select {
+	case reason := <-events:
+		if reason != EvictReasonDeleted {
+			t.Errorf("Got reason %v, expected %v", reason, EvictReasonDeleted)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for eviction event")
+	}
+}
+
+// benchmarkConcurrentGet drives count keys through a pre-populated cache
+// from multiple goroutines at once, to measure how throughput scales with
+// shard count.
+func benchmarkConcurrentGet(c interface{ Get(string) int }, count int, b *testing.B) {
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			_ = c.Get(strconv.Itoa(i % count))
+			i++
+		}
+	})
+}
+
+func BenchmarkConcurrentGet10000Unsharded(b *testing.B) {
+	c := New[int]()
+	for i := 0; i < 10000; i++ {
+		c.Set(strconv.Itoa(i), i)
+	}
+
+	benchmarkConcurrentGet(c, 10000, b)
+}
+
+func BenchmarkConcurrentGet10000Shards1(b *testing.B) {
+	c := NewSharded[int](1)
+	for i := 0; i < 10000; i++ {
+		c.Set(strconv.Itoa(i), i)
+	}
+
+	benchmarkConcurrentGet(c, 10000, b)
+}
+
+func BenchmarkConcurrentGet10000Shards8(b *testing.B) {
+	c := NewSharded[int](8)
+	for i := 0; i < 10000; i++ {
+		c.Set(strconv.Itoa(i), i)
+	}
+
+	benchmarkConcurrentGet(c, 10000, b)
+}
+
+func BenchmarkConcurrentGet10000Shards32(b *testing.B) {
+	c := NewSharded[int](32)
+	for i := 0; i < 10000; i++ {
+		c.Set(strconv.Itoa(i), i)
+	}
+
+	benchmarkConcurrentGet(c, 10000, b)
+}
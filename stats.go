@@ -0,0 +1,33 @@
+package cache
+
+// Stats is a point-in-time snapshot of a Cache's activity counters, as
+// returned by Cache.Stats.
+type Stats struct {
+	Hits              uint64
+	Misses            uint64
+	Insertions        uint64
+	Expirations       uint64
+	Evictions         uint64
+	EvictionsByReason map[EvictReason]uint64
+	Size              uint64
+}
+
+// cacheStats accumulates the raw counters backing Stats. It is only ever
+// read and written by a Cache's loopItemOps goroutine, so it needs no
+// synchronization of its own.
+type cacheStats struct {
+	hits              uint64
+	misses            uint64
+	insertions        uint64
+	evictions         uint64
+	evictionsByReason map[EvictReason]uint64
+}
+
+func newCacheStats() *cacheStats {
+	return &cacheStats{evictionsByReason: map[EvictReason]uint64{}}
+}
+
+func (s *cacheStats) recordEviction(reason EvictReason) {
+	s.evictions++
+	s.evictionsByReason[reason]++
+}
@@ -0,0 +1,56 @@
+package cache
+
+import "testing"
+
+func TestStats(t *testing.T) {
+	c := New[int]()
+	c.Set("1", 1)
+	c.Set("2", 2)
+	c.Get("1")
+	c.Get("1")
+	c.Get("missing")
+	c.Delete("2")
+
+	stats := c.Stats()
+	if stats.Hits != 2 {
+		t.Errorf("Hits was %d, expected 2", stats.Hits)
+	}
+
+	if stats.Misses != 1 {
+		t.Errorf("Misses was %d, expected 1", stats.Misses)
+	}
+
+	if stats.Insertions != 2 {
+		t.Errorf("Insertions was %d, expected 2", stats.Insertions)
+	}
+
+	if stats.Evictions != 1 {
+		t.Errorf("Evictions was %d, expected 1", stats.Evictions)
+	}
+
+	if result := stats.EvictionsByReason[EvictReasonDeleted]; result != 1 {
+		t.Errorf("EvictionsByReason[deleted] was %d, expected 1", result)
+	}
+
+	if stats.Size != 1 {
+		t.Errorf("Size was %d, expected 1", stats.Size)
+	}
+}
+
+func TestHitRatio(t *testing.T) {
+	c := New[int]()
+
+	if ratio := c.HitRatio(); ratio != 0 {
+		t.Errorf("HitRatio for an empty cache was %v, expected 0", ratio)
+	}
+
+	c.Set("1", 1)
+	c.Get("1")
+	c.Get("1")
+	c.Get("1")
+	c.Get("missing")
+
+	if ratio, expected := c.HitRatio(), 0.75; ratio != expected {
+		t.Errorf("HitRatio was %v, expected %v", ratio, expected)
+	}
+}